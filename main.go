@@ -2,8 +2,7 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -23,6 +22,10 @@ const (
 )
 
 func main() {
+	// Failed and slow tries are always written to stderr (or syslog) by the request log policy, regardless of
+	// RequestLogOptions below. Uncomment the line below to silence that output, e.g. for quiet CLI usage.
+	// pipeline.SetForceLogEnabled(false)
+
 	// Create/configure a request pipeline options object.
 	// All PipelineOptions' fields are optional; reasonable defaults are set for anything you do not specify
 	po := azblob.PipelineOptions{
@@ -37,7 +40,7 @@ func main() {
 
 		// Set RequestLogOptions to control how each HTTP request & its response is logged
 		RequestLog: azblob.RequestLogOptions{
-			LogWarningIfTryOverThreshold: time.Millisecond * 200, // A successful response taking more than this time to arrive is logged as a warning
+			LogWarningIfTryOverThreshold: time.Millisecond * 200, // A successful response taking more than this time to arrive is logged as a warning (-1 disables the warning; 0 uses the 3 second default)
 		},
 
 		// Set HTTPSender to override the default HTTP Sender that sends the request over the network
@@ -106,15 +109,22 @@ func main() {
 		log.Println(err)
 		return
 	}
-	body := resp.Body(azblob.RetryReaderOptions{})
+	// Set RetryReaderOptions to control how reading the body recovers from network failures part way through
+	body := resp.Body(azblob.RetryReaderOptions{
+		MaxRetryRequests:       3,    // Issue at most 3 additional ranged GETs to resume reading (0 disables retries)
+		TreatEarlyCloseAsError: true, // Closing the body before it is fully read is fatal rather than triggering a retry
+		NotifyFailedRead: func(failureCount int, lastError error, offset int64, count int64, willRetry bool) {
+			log.Printf("read failed (attempt %d, offset %d, will retry: %t): %v", failureCount, offset, willRetry, lastError)
+		},
+	})
 	defer body.Close()
 
-	b, err := ioutil.ReadAll(body)
+	// Stream the body into any io.Writer (a file, an http.ResponseWriter, ...) instead of buffering it in memory
+	_, err = io.Copy(os.Stdout, body)
 	if err != nil {
 		log.Println(err)
 		return
 	}
-	fmt.Printf("%s", b)
 
 	_, err = blobURL.Delete(ctx, azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
 	if err != nil {